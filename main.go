@@ -2,12 +2,14 @@ package main
 
 import (
 	"fmt"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
 	"github.com/davealexenglish/projectShell/pkg/user"
 )
 
 func main() {
 	// Create a new user
-	u := user.NewUser("Alice", "alice@example.com")
+	u := user.NewUser(identity.Person{Name: "Alice", Email: "alice@example.com"})
 
 	fmt.Printf("Created user: %s\n", u.String())
 	fmt.Printf("User ID: %s\n", u.ID)
@@ -0,0 +1,65 @@
+// Package email provides strict email address validation shared by
+// packages (such as pkg/user and pkg/identity) that need to agree on what
+// counts as a valid address without depending on each other.
+package email
+
+import (
+	"errors"
+	"net"
+	"net/mail"
+	"strings"
+)
+
+// ErrEmailFormat indicates the supplied string is not a valid, bare email
+// address (e.g. it failed RFC 5322 parsing, or it parsed but carried extra
+// content such as a display name).
+var ErrEmailFormat = errors.New("email: invalid format")
+
+// ErrEmailNoMX indicates the email's domain has no MX records, or that the
+// MX lookup for it failed.
+var ErrEmailNoMX = errors.New("email: domain has no MX records")
+
+// ValidateEmailOptions configures the optional checks performed by
+// ValidateEmailWithOptions.
+type ValidateEmailOptions struct {
+	// CheckMX, when true, performs a DNS MX lookup on the email's domain and
+	// fails validation if none are found or the lookup returns an error.
+	CheckMX bool
+}
+
+// ValidateEmail checks that addr is a valid, bare "local@domain" address.
+// It parses the address with net/mail and rejects anything that isn't
+// exactly reproduced by the parse, which excludes display names (e.g.
+// "Alice <alice@example.com>"), multiple addresses, and surrounding
+// whitespace.
+func ValidateEmail(addr string) error {
+	return ValidateEmailWithOptions(addr, ValidateEmailOptions{})
+}
+
+// ValidateEmailWithOptions is ValidateEmail with additional, opt-in checks.
+func ValidateEmailWithOptions(addr string, opts ValidateEmailOptions) error {
+	parsed, err := mail.ParseAddress(addr)
+	if err != nil || parsed.Address != addr {
+		return ErrEmailFormat
+	}
+
+	if opts.CheckMX {
+		domain := addr[strings.LastIndex(addr, "@")+1:]
+		mxs, err := net.LookupMX(domain)
+		if err != nil || len(mxs) == 0 {
+			return ErrEmailNoMX
+		}
+	}
+
+	return nil
+}
+
+// NormalizeEmail lowercases the domain portion of addr, leaving the local
+// part untouched since it may be case-sensitive per RFC 5321.
+func NormalizeEmail(addr string) string {
+	at := strings.LastIndex(addr, "@")
+	if at == -1 {
+		return addr
+	}
+	return addr[:at] + "@" + strings.ToLower(addr[at+1:])
+}
@@ -0,0 +1,47 @@
+package email
+
+import "testing"
+
+func TestValidateEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr error
+	}{
+		{"valid", "alice@example.com", nil},
+		{"valid unicode local part", "tëst@example.com", nil},
+		{"display name rejected", "Alice <alice@example.com>", ErrEmailFormat},
+		{"trailing whitespace rejected", "alice@example.com ", ErrEmailFormat},
+		{"malformed", "not-an-email", ErrEmailFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEmail(tt.addr)
+			if err != tt.wantErr {
+				t.Errorf("ValidateEmail(%q) = %v, want %v", tt.addr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want string
+	}{
+		{"lowercases domain", "Alice@EXAMPLE.COM", "Alice@example.com"},
+		{"leaves local part case", "Alice@example.com", "Alice@example.com"},
+		{"no @ is unchanged", "not-an-email", "not-an-email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeEmail(tt.addr)
+			if got != tt.want {
+				t.Errorf("NormalizeEmail(%q) = %q, want %q", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package event is an in-process pub/sub bus for decoupling side effects
+// (audit logs, cache invalidation, webhooks, ...) from the code that
+// triggers them.
+package event
+
+import (
+	"context"
+	"sync"
+)
+
+// Handler processes a payload emitted on a topic.
+type Handler func(ctx context.Context, payload any)
+
+// Subscription identifies a registered handler so it can later be removed
+// with Unsubscribe.
+type Subscription struct {
+	topic string
+	id    uint64
+}
+
+type bus struct {
+	mu   sync.Mutex
+	subs map[string]map[uint64]Handler
+	next uint64
+}
+
+var defaultBus = &bus{subs: make(map[string]map[uint64]Handler)}
+
+// Subscribe registers handler to run whenever Emit is called on topic.
+// Each delivery invokes handler in its own goroutine, so handlers run
+// concurrently with each other and with the Emit caller.
+func Subscribe(topic string, handler Handler) Subscription {
+	return defaultBus.subscribe(topic, handler)
+}
+
+// Unsubscribe removes a subscription previously returned by Subscribe. It is
+// a no-op if sub has already been removed.
+func Unsubscribe(sub Subscription) {
+	defaultBus.unsubscribe(sub)
+}
+
+// Emit dispatches payload to every handler currently subscribed to topic.
+// Emit does not wait for handlers to finish.
+func Emit(topic string, payload any) {
+	defaultBus.emit(topic, payload)
+}
+
+func (b *bus) subscribe(topic string, handler Handler) Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[uint64]Handler)
+	}
+	id := b.next
+	b.next++
+	b.subs[topic][id] = handler
+	return Subscription{topic: topic, id: id}
+}
+
+func (b *bus) unsubscribe(sub Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	handlers := b.subs[sub.topic]
+	delete(handlers, sub.id)
+	if len(handlers) == 0 {
+		delete(b.subs, sub.topic)
+	}
+}
+
+func (b *bus) emit(topic string, payload any) {
+	b.mu.Lock()
+	handlers := make([]Handler, 0, len(b.subs[topic]))
+	for _, h := range b.subs[topic] {
+		handlers = append(handlers, h)
+	}
+	b.mu.Unlock()
+
+	for _, h := range handlers {
+		go h(context.Background(), payload)
+	}
+}
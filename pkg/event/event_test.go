@@ -0,0 +1,44 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEmitDeliversToSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got any
+	sub := Subscribe("test.emit", func(_ context.Context, payload any) {
+		got = payload
+		cancel()
+	})
+	defer Unsubscribe(sub)
+
+	Emit("test.emit", "hello")
+
+	<-ctx.Done()
+	if got != "hello" {
+		t.Errorf("got = %v, want hello", got)
+	}
+}
+
+func TestUnsubscribeStopsDelivery(t *testing.T) {
+	sub := Subscribe("test.unsubscribe", func(context.Context, any) {
+		t.Error("handler should not run after Unsubscribe")
+	})
+	Unsubscribe(sub)
+
+	Emit("test.unsubscribe", "hello")
+
+	// Give any (incorrectly) still-registered handler a chance to run.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+}
+
+func TestEmitWithNoSubscribersIsANoop(t *testing.T) {
+	Emit("test.nobody-listening", "hello")
+}
@@ -0,0 +1,176 @@
+// Package identity resolves who is performing an action — name, email, and
+// optionally an avatar — the way Git resolves committer identity from
+// config, and validates the result for use elsewhere (e.g. as the Person
+// behind a pkg/user.User).
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode"
+
+	"github.com/davealexenglish/projectShell/pkg/email"
+)
+
+// Person identifies someone by name, email, and optional avatar.
+type Person struct {
+	Name      string
+	Email     string
+	AvatarURL string
+}
+
+// Repo is a source of repo-scoped configuration, mirroring "git config
+// <key>". Implementations include GitConfigRepo, EnvRepo, and MapRepo.
+type Repo interface {
+	// ConfigValue returns the value for key, or "" if it is unset.
+	ConfigValue(key string) (string, error)
+}
+
+// ErrNameUnset is returned by Resolve when user.name has no value.
+var ErrNameUnset = errors.New("identity: user.name is not set")
+
+// ErrEmailUnset is returned by Resolve when user.email has no value.
+var ErrEmailUnset = errors.New("identity: user.email is not set")
+
+// Resolve reads user.name, user.email, and (if present) user.avatarURL from
+// repo and returns them as a Person.
+func Resolve(repo Repo) (Person, error) {
+	name, err := repo.ConfigValue("user.name")
+	if err != nil {
+		return Person{}, fmt.Errorf("identity: resolving user.name: %w", err)
+	}
+	if name == "" {
+		return Person{}, ErrNameUnset
+	}
+
+	addr, err := repo.ConfigValue("user.email")
+	if err != nil {
+		return Person{}, fmt.Errorf("identity: resolving user.email: %w", err)
+	}
+	if addr == "" {
+		return Person{}, ErrEmailUnset
+	}
+
+	avatar, err := repo.ConfigValue("user.avatarURL")
+	if err != nil {
+		return Person{}, fmt.Errorf("identity: resolving user.avatarURL: %w", err)
+	}
+
+	return Person{Name: name, Email: addr, AvatarURL: avatar}, nil
+}
+
+// ErrNameEmpty is returned by Person.Validate when Name is empty.
+var ErrNameEmpty = errors.New("identity: name is empty")
+
+// ErrNameInvalid is returned by Person.Validate when Name contains a
+// newline or non-printable character.
+var ErrNameInvalid = errors.New("identity: name must be a single line of printable characters")
+
+// ErrEmailInvalid is returned by Person.Validate when Email contains a
+// newline or non-printable character.
+var ErrEmailInvalid = errors.New("identity: email must be a single line of printable characters")
+
+// ErrAvatarURLInvalid is returned by Person.Validate when AvatarURL is set
+// but is not an absolute http(s) URL.
+var ErrAvatarURLInvalid = errors.New("identity: avatar URL must be an absolute http(s) URL")
+
+// Validate checks that p.Name is non-empty, single-line, and printable;
+// that p.Email is single-line, printable, and a strictly valid address; and
+// that p.AvatarURL, if set, is an absolute http(s) URL.
+func (p Person) Validate() error {
+	if p.Name == "" {
+		return ErrNameEmpty
+	}
+	if !isSingleLinePrintable(p.Name) {
+		return ErrNameInvalid
+	}
+	if !isSingleLinePrintable(p.Email) {
+		return ErrEmailInvalid
+	}
+	if err := email.ValidateEmail(p.Email); err != nil {
+		return fmt.Errorf("identity: invalid email: %w", err)
+	}
+	if p.AvatarURL != "" {
+		u, err := url.ParseRequestURI(p.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrAvatarURLInvalid
+		}
+	}
+	return nil
+}
+
+// Match reports whether query matches p.Name or p.Email, case-insensitively,
+// as a substring. It's intended for building user pickers.
+func (p Person) Match(query string) bool {
+	q := strings.ToLower(query)
+	return strings.Contains(strings.ToLower(p.Name), q) || strings.Contains(strings.ToLower(p.Email), q)
+}
+
+func isSingleLinePrintable(s string) bool {
+	for _, r := range s {
+		if r == '\n' || r == '\r' || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// GitConfigRepo reads config via the git CLI, equivalent to running
+// `git config --get <key>` in Dir.
+type GitConfigRepo struct {
+	// Dir is the working directory to run git in. Empty uses the current
+	// directory.
+	Dir string
+}
+
+func (r GitConfigRepo) ConfigValue(key string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", key)
+	if r.Dir != "" {
+		cmd.Dir = r.Dir
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			// git config exits 1 when the key is unset.
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// EnvRepo reads config from environment variables, following Git's
+// GIT_AUTHOR_*/GIT_COMMITTER_* naming convention.
+type EnvRepo struct {
+	// Prefix is "GIT_AUTHOR" or "GIT_COMMITTER". Empty defaults to
+	// "GIT_AUTHOR".
+	Prefix string
+}
+
+func (r EnvRepo) ConfigValue(key string) (string, error) {
+	prefix := r.Prefix
+	if prefix == "" {
+		prefix = "GIT_AUTHOR"
+	}
+	switch key {
+	case "user.name":
+		return os.Getenv(prefix + "_NAME"), nil
+	case "user.email":
+		return os.Getenv(prefix + "_EMAIL"), nil
+	default:
+		return "", nil
+	}
+}
+
+// MapRepo is a Repo backed by a plain map, e.g. for tests or config already
+// parsed into key/value pairs.
+type MapRepo map[string]string
+
+func (r MapRepo) ConfigValue(key string) (string, error) {
+	return r[key], nil
+}
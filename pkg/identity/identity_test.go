@@ -0,0 +1,69 @@
+package identity
+
+import "testing"
+
+func TestResolve(t *testing.T) {
+	p, err := Resolve(MapRepo{
+		"user.name":  "Ada Lovelace",
+		"user.email": "ada@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if p.Name != "Ada Lovelace" || p.Email != "ada@example.com" {
+		t.Errorf("Resolve() = %+v, want Name/Email set", p)
+	}
+}
+
+func TestResolveMissingName(t *testing.T) {
+	_, err := Resolve(MapRepo{"user.email": "ada@example.com"})
+	if err != ErrNameUnset {
+		t.Errorf("Resolve() error = %v, want ErrNameUnset", err)
+	}
+}
+
+func TestResolveMissingEmail(t *testing.T) {
+	_, err := Resolve(MapRepo{"user.name": "Ada Lovelace"})
+	if err != ErrEmailUnset {
+		t.Errorf("Resolve() error = %v, want ErrEmailUnset", err)
+	}
+}
+
+func TestPersonValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		person  Person
+		wantErr bool
+	}{
+		{"valid", Person{Name: "Ada Lovelace", Email: "ada@example.com"}, false},
+		{"valid with avatar", Person{Name: "Ada", Email: "ada@example.com", AvatarURL: "https://example.com/a.png"}, false},
+		{"empty name", Person{Name: "", Email: "ada@example.com"}, true},
+		{"multiline name", Person{Name: "Ada\nLovelace", Email: "ada@example.com"}, true},
+		{"invalid email", Person{Name: "Ada", Email: "not-an-email"}, true},
+		{"non-http avatar", Person{Name: "Ada", Email: "ada@example.com", AvatarURL: "ftp://example.com/a.png"}, true},
+		{"relative avatar", Person{Name: "Ada", Email: "ada@example.com", AvatarURL: "/a.png"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.person.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPersonMatch(t *testing.T) {
+	p := Person{Name: "Ada Lovelace", Email: "ada@example.com"}
+
+	if !p.Match("lovelace") {
+		t.Error("Match(\"lovelace\") = false, want true")
+	}
+	if !p.Match("ADA@EXAMPLE") {
+		t.Error("Match(\"ADA@EXAMPLE\") = false, want true")
+	}
+	if p.Match("nobody") {
+		t.Error("Match(\"nobody\") = true, want false")
+	}
+}
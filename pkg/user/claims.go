@@ -0,0 +1,47 @@
+package user
+
+// Claims returns u's standard OpenID Connect claims, as a map suitable for
+// embedding in an ID token.
+func (u *User) Claims() map[string]interface{} {
+	claims := make(map[string]interface{})
+	u.AddToClaims(claims)
+	return claims
+}
+
+// AddToClaims writes u's standard OpenID Connect claim names into claims:
+// "sub" from ID, "name" from DisplayName (falling back to Name),
+// "preferred_username" from Username, and "email"/"email_verified" when an
+// email is present.
+func (u *User) AddToClaims(claims map[string]interface{}) {
+	claims["sub"] = u.ID
+
+	name := u.DisplayName
+	if name == "" {
+		name = u.Name
+	}
+	if name != "" {
+		claims["name"] = name
+	}
+
+	if u.Username != "" {
+		claims["preferred_username"] = u.Username
+	}
+
+	if u.Email != "" {
+		claims["email"] = u.Email
+		claims["email_verified"] = u.EmailVerified
+	}
+}
+
+// Sanitize returns a copy of u with fields named by options cleared. The
+// only recognized key today is "email", which clears Email and
+// EmailVerified; unrecognized keys are ignored so callers can pass a
+// broader options set shared across types.
+func (u *User) Sanitize(options map[string]bool) *User {
+	cp := *u
+	if options["email"] {
+		cp.Email = ""
+		cp.EmailVerified = false
+	}
+	return &cp
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
+)
+
+func TestUserClaims(t *testing.T) {
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	u.Username = "ada"
+	u.EmailVerified = true
+
+	claims := u.Claims()
+
+	if claims["sub"] != u.ID {
+		t.Errorf("claims[sub] = %v, want %v", claims["sub"], u.ID)
+	}
+	if claims["name"] != "Ada Lovelace" {
+		t.Errorf("claims[name] = %v, want Ada Lovelace", claims["name"])
+	}
+	if claims["preferred_username"] != "ada" {
+		t.Errorf("claims[preferred_username] = %v, want ada", claims["preferred_username"])
+	}
+	if claims["email"] != "ada@example.com" {
+		t.Errorf("claims[email] = %v, want ada@example.com", claims["email"])
+	}
+	if claims["email_verified"] != true {
+		t.Errorf("claims[email_verified] = %v, want true", claims["email_verified"])
+	}
+}
+
+func TestUserClaimsDisplayNameOverridesName(t *testing.T) {
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	u.DisplayName = "Countess of Lovelace"
+
+	claims := u.Claims()
+
+	if claims["name"] != "Countess of Lovelace" {
+		t.Errorf("claims[name] = %v, want Countess of Lovelace", claims["name"])
+	}
+}
+
+func TestUserSanitize(t *testing.T) {
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	u.EmailVerified = true
+
+	sanitized := u.Sanitize(map[string]bool{"email": true})
+
+	if sanitized.Email != "" || sanitized.EmailVerified {
+		t.Errorf("Sanitize(email) left Email=%q EmailVerified=%v, want cleared", sanitized.Email, sanitized.EmailVerified)
+	}
+	if u.Email == "" {
+		t.Error("Sanitize() mutated the receiver; want a copy")
+	}
+}
@@ -0,0 +1,33 @@
+package user
+
+import "github.com/davealexenglish/projectShell/pkg/email"
+
+// ValidateEmailOptions, ValidateEmail, ValidateEmailWithOptions, and
+// NormalizeEmail are re-exported from pkg/email, which holds the canonical
+// implementation so pkg/identity can validate addresses without importing
+// pkg/user.
+type ValidateEmailOptions = email.ValidateEmailOptions
+
+var (
+	// ErrEmailFormat indicates the supplied string is not a valid, bare
+	// email address.
+	ErrEmailFormat = email.ErrEmailFormat
+	// ErrEmailNoMX indicates the email's domain has no MX records, or that
+	// the MX lookup for it failed.
+	ErrEmailNoMX = email.ErrEmailNoMX
+)
+
+// ValidateEmail checks that addr is a valid, bare "local@domain" address.
+func ValidateEmail(addr string) error {
+	return email.ValidateEmail(addr)
+}
+
+// ValidateEmailWithOptions is ValidateEmail with additional, opt-in checks.
+func ValidateEmailWithOptions(addr string, opts ValidateEmailOptions) error {
+	return email.ValidateEmailWithOptions(addr, opts)
+}
+
+// NormalizeEmail lowercases the domain portion of addr.
+func NormalizeEmail(addr string) string {
+	return email.NormalizeEmail(addr)
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/davealexenglish/projectShell/pkg/email"
+)
+
+// ValidateEmail, ValidateEmailWithOptions, and NormalizeEmail are thin
+// re-exports of pkg/email; the exhaustive cases live in that package's
+// tests. These just confirm the forwarding is wired correctly.
+
+func TestValidateEmailForwardsToPkgEmail(t *testing.T) {
+	if err := ValidateEmail("alice@example.com"); err != nil {
+		t.Errorf("ValidateEmail() error = %v, want nil", err)
+	}
+	if err := ValidateEmail("not-an-email"); err != email.ErrEmailFormat {
+		t.Errorf("ValidateEmail() error = %v, want ErrEmailFormat", err)
+	}
+}
+
+func TestNormalizeEmailForwardsToPkgEmail(t *testing.T) {
+	if got := NormalizeEmail("Alice@EXAMPLE.COM"); got != "Alice@example.com" {
+		t.Errorf("NormalizeEmail() = %q, want Alice@example.com", got)
+	}
+}
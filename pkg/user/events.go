@@ -0,0 +1,30 @@
+package user
+
+import "github.com/davealexenglish/projectShell/pkg/event"
+
+// Topics emitted by Store implementations as users are created, updated,
+// deleted, or have their email changed.
+const (
+	TopicUserCreated      = "user.created"
+	TopicUserUpdated      = "user.updated"
+	TopicUserDeleted      = "user.deleted"
+	TopicUserEmailChanged = "user.email_changed"
+)
+
+// EmailChangedEvent is the payload emitted on TopicUserEmailChanged.
+type EmailChangedEvent struct {
+	User     *User
+	OldEmail string
+}
+
+// DeletedEvent is the payload emitted on TopicUserDeleted.
+type DeletedEvent struct {
+	ID string
+}
+
+func emitUpdated(old, updated *User) {
+	event.Emit(TopicUserUpdated, updated)
+	if emailKey(old.Email) != emailKey(updated.Email) {
+		event.Emit(TopicUserEmailChanged, &EmailChangedEvent{User: updated, OldEmail: old.Email})
+	}
+}
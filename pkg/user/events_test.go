@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/davealexenglish/projectShell/pkg/event"
+	"github.com/davealexenglish/projectShell/pkg/identity"
+)
+
+func TestMemoryStoreEmitsLifecycleEvents(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	createdCtx, createdDone := context.WithCancel(context.Background())
+	sub := event.Subscribe(TopicUserCreated, func(context.Context, any) { createdDone() })
+	defer event.Unsubscribe(sub)
+
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	if err := store.Create(ctx, u); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	<-createdCtx.Done()
+
+	emailChangedCtx, emailChangedDone := context.WithCancel(context.Background())
+	sub2 := event.Subscribe(TopicUserEmailChanged, func(_ context.Context, payload any) {
+		evt, ok := payload.(*EmailChangedEvent)
+		if !ok || evt.OldEmail != "ada@example.com" {
+			t.Errorf("unexpected email_changed payload: %#v", payload)
+		}
+		emailChangedDone()
+	})
+	defer event.Unsubscribe(sub2)
+
+	u.Email = "ada@newdomain.com"
+	if err := store.Update(ctx, u); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	<-emailChangedCtx.Done()
+
+	deletedCtx, deletedDone := context.WithCancel(context.Background())
+	sub3 := event.Subscribe(TopicUserDeleted, func(_ context.Context, payload any) {
+		evt, ok := payload.(*DeletedEvent)
+		if !ok || evt.ID != u.ID {
+			t.Errorf("unexpected deleted payload: %#v", payload)
+		}
+		deletedDone()
+	})
+	defer event.Unsubscribe(sub3)
+
+	if err := store.Delete(ctx, u.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	<-deletedCtx.Done()
+}
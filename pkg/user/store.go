@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotFound is returned by Store methods when no user matches the lookup.
+var ErrNotFound = errors.New("user: not found")
+
+// ErrAlreadyExists is returned by Store.Create when a user with the same ID
+// or email already exists.
+var ErrAlreadyExists = errors.New("user: already exists")
+
+// Store persists users. Implementations must be safe for concurrent use.
+type Store interface {
+	Create(ctx context.Context, u *User) error
+	Get(ctx context.Context, id string) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	Update(ctx context.Context, u *User) error
+	Delete(ctx context.Context, id string) error
+	// List returns users matching filter, along with a page token to pass
+	// as ListOptions.PageToken to fetch the next page. The returned token is
+	// empty once there are no more results.
+	List(ctx context.Context, filter ListFilter, opts ListOptions) ([]*User, string, error)
+}
+
+// ListFilter narrows List results. A zero-value ListFilter matches every
+// user.
+type ListFilter struct {
+	// NameContains, if set, matches users whose Name contains this substring
+	// (case-insensitive).
+	NameContains string
+	// EmailContains, if set, matches users whose Email contains this
+	// substring (case-insensitive).
+	EmailContains string
+}
+
+// ListOptions controls pagination for List.
+type ListOptions struct {
+	// Limit caps the number of users returned. A value <= 0 means
+	// defaultListLimit. When PageToken is set, the limit carried in the
+	// token takes precedence over this field.
+	Limit int
+	// PageToken, if non-empty, resumes a previous List call. It must come
+	// from a prior call's returned token.
+	PageToken string
+}
+
+const defaultListLimit = 100
+
+// pageToken is the opaque cursor encoded into ListOptions.PageToken and the
+// token returned by List.
+type pageToken struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+func encodePageToken(t pageToken) string {
+	b, err := json.Marshal(t)
+	if err != nil {
+		// t only ever contains ints, so this cannot fail.
+		panic(err)
+	}
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	if s == "" {
+		return pageToken{}, nil
+	}
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return pageToken{}, fmt.Errorf("user: invalid page token: %w", err)
+	}
+	var t pageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return pageToken{}, fmt.Errorf("user: invalid page token: %w", err)
+	}
+	if t.Offset < 0 || t.Limit < 0 {
+		return pageToken{}, fmt.Errorf("user: invalid page token: offset and limit must be non-negative")
+	}
+	return t, nil
+}
+
+// resolveListOptions applies defaults and decodes opts into the offset/limit
+// to use for this page.
+func resolveListOptions(opts ListOptions) (offset, limit int, err error) {
+	tok, err := decodePageToken(opts.PageToken)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limit = opts.Limit
+	if tok.Limit > 0 {
+		limit = tok.Limit
+	}
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	return tok.Offset, limit, nil
+}
+
+func matchesFilter(u *User, filter ListFilter) bool {
+	if filter.NameContains != "" && !containsFold(u.Name, filter.NameContains) {
+		return false
+	}
+	if filter.EmailContains != "" && !containsFold(u.Email, filter.EmailContains) {
+		return false
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// emailKey is the case-insensitive key Store implementations use to dedupe
+// and look up users by email. Unlike NormalizeEmail (which only folds the
+// domain, for display), emailKey folds the whole address, since in practice
+// almost no mail provider treats the local part as case-sensitive and every
+// Store backend needs to agree on one notion of "same email".
+func emailKey(addr string) string {
+	return strings.ToLower(addr)
+}
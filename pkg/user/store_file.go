@@ -0,0 +1,239 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/davealexenglish/projectShell/pkg/event"
+)
+
+// FileStore is a Store backed by a single JSON file. Writes are applied by
+// writing to a temporary file and renaming it over the target, so readers
+// never observe a partially-written file.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore returns a FileStore persisting to path. The file is created
+// on first write if it does not already exist.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+type fileStoreData struct {
+	Users map[string]*User `json:"users"`
+}
+
+func (s *FileStore) load() (fileStoreData, error) {
+	data := fileStoreData{Users: make(map[string]*User)}
+
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return data, nil
+	}
+	if err != nil {
+		return data, err
+	}
+	if len(b) == 0 {
+		return data, nil
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return data, fmt.Errorf("user: corrupt store file %s: %w", s.path, err)
+	}
+	if data.Users == nil {
+		data.Users = make(map[string]*User)
+	}
+	return data, nil
+}
+
+func (s *FileStore) save(data fileStoreData) error {
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // no-op once the rename below succeeds
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, s.path)
+}
+
+func (s *FileStore) Create(ctx context.Context, u *User) error {
+	s.mu.Lock()
+
+	data, err := s.load()
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	if _, ok := data.Users[u.ID]; ok {
+		s.mu.Unlock()
+		return ErrAlreadyExists
+	}
+	key := emailKey(u.Email)
+	for _, existing := range data.Users {
+		if emailKey(existing.Email) == key {
+			s.mu.Unlock()
+			return ErrAlreadyExists
+		}
+	}
+
+	cp := *u
+	data.Users[u.ID] = &cp
+	err = s.save(data)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	created := cp
+	event.Emit(TopicUserCreated, &created)
+	return nil
+}
+
+func (s *FileStore) Get(ctx context.Context, id string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	u, ok := data.Users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (s *FileStore) GetByEmail(ctx context.Context, email string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	key := emailKey(email)
+	for _, u := range data.Users {
+		if emailKey(u.Email) == key {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *FileStore) Update(ctx context.Context, u *User) error {
+	s.mu.Lock()
+
+	data, err := s.load()
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	existing, ok := data.Users[u.ID]
+	if !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	old := *existing
+	key := emailKey(u.Email)
+	for id, other := range data.Users {
+		if id != u.ID && emailKey(other.Email) == key {
+			s.mu.Unlock()
+			return ErrAlreadyExists
+		}
+	}
+
+	cp := *u
+	data.Users[u.ID] = &cp
+	err = s.save(data)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	emitUpdated(&old, &cp)
+	return nil
+}
+
+func (s *FileStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+
+	data, err := s.load()
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	if _, ok := data.Users[id]; !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	delete(data.Users, id)
+	err = s.save(data)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	event.Emit(TopicUserDeleted, &DeletedEvent{ID: id})
+	return nil
+}
+
+func (s *FileStore) List(ctx context.Context, filter ListFilter, opts ListOptions) ([]*User, string, error) {
+	offset, limit, err := resolveListOptions(opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	data, err := s.load()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, "", err
+	}
+
+	matched := make([]*User, 0, len(data.Users))
+	for _, u := range data.Users {
+		if matchesFilter(u, filter) {
+			cp := *u
+			matched = append(matched, &cp)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+
+	if offset >= len(matched) {
+		return nil, "", nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	page := matched[offset:end]
+
+	var next string
+	if end < len(matched) {
+		next = encodePageToken(pageToken{Offset: end, Limit: limit})
+	}
+	return page, next, nil
+}
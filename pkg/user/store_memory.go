@@ -0,0 +1,148 @@
+package user
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/davealexenglish/projectShell/pkg/event"
+)
+
+// MemoryStore is a Store backed by an in-memory map. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	byID    map[string]*User
+	emailID map[string]string // emailKey(email) -> user ID
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		byID:    make(map[string]*User),
+		emailID: make(map[string]string),
+	}
+}
+
+func (s *MemoryStore) Create(ctx context.Context, u *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.byID[u.ID]; ok {
+		return ErrAlreadyExists
+	}
+	key := emailKey(u.Email)
+	if _, ok := s.emailID[key]; ok {
+		return ErrAlreadyExists
+	}
+
+	cp := *u
+	s.byID[u.ID] = &cp
+	s.emailID[key] = u.ID
+
+	created := cp
+	event.Emit(TopicUserCreated, &created)
+	return nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, id string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.byID[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (s *MemoryStore) GetByEmail(ctx context.Context, email string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id, ok := s.emailID[emailKey(email)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *s.byID[id]
+	return &cp, nil
+}
+
+func (s *MemoryStore) Update(ctx context.Context, u *User) error {
+	s.mu.Lock()
+
+	existing, ok := s.byID[u.ID]
+	if !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	old := *existing
+
+	newKey := emailKey(u.Email)
+	if oldKey := emailKey(existing.Email); oldKey != newKey {
+		if ownerID, ok := s.emailID[newKey]; ok && ownerID != u.ID {
+			s.mu.Unlock()
+			return ErrAlreadyExists
+		}
+		delete(s.emailID, oldKey)
+		s.emailID[newKey] = u.ID
+	}
+
+	cp := *u
+	s.byID[u.ID] = &cp
+	s.mu.Unlock()
+
+	emitUpdated(&old, &cp)
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+
+	existing, ok := s.byID[id]
+	if !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	delete(s.emailID, emailKey(existing.Email))
+	delete(s.byID, id)
+	s.mu.Unlock()
+
+	event.Emit(TopicUserDeleted, &DeletedEvent{ID: id})
+	return nil
+}
+
+func (s *MemoryStore) List(ctx context.Context, filter ListFilter, opts ListOptions) ([]*User, string, error) {
+	offset, limit, err := resolveListOptions(opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	matched := make([]*User, 0, len(s.byID))
+	for _, u := range s.byID {
+		if matchesFilter(u, filter) {
+			cp := *u
+			matched = append(matched, &cp)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+
+	if offset >= len(matched) {
+		return nil, "", nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	page := matched[offset:end]
+
+	var next string
+	if end < len(matched) {
+		next = encodePageToken(pageToken{Offset: end, Limit: limit})
+	}
+	return page, next, nil
+}
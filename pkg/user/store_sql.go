@@ -0,0 +1,224 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/davealexenglish/projectShell/pkg/event"
+)
+
+// SQLDialect selects the SQL dialect an SQLStore talks to, since the schema
+// and parameter placeholder syntax differ between them.
+type SQLDialect string
+
+const (
+	DialectSQLite   SQLDialect = "sqlite"
+	DialectPostgres SQLDialect = "postgres"
+)
+
+// SQLStore is a Store backed by database/sql. Callers are responsible for
+// opening db with an already-registered driver matching dialect (e.g.
+// "sqlite3" or "pgx"/"postgres").
+type SQLStore struct {
+	db      *sql.DB
+	dialect SQLDialect
+}
+
+// NewSQLStore returns a Store that reads and writes through db using the
+// given dialect. Call Migrate before first use to create the users table.
+func NewSQLStore(db *sql.DB, dialect SQLDialect) *SQLStore {
+	return &SQLStore{db: db, dialect: dialect}
+}
+
+// email_key stores emailKey(email) so lookups and the uniqueness
+// constraint are case-insensitive, matching MemoryStore and FileStore,
+// without relying on dialect-specific expression indexes.
+var sqlSchema = map[SQLDialect]string{
+	DialectSQLite: `
+CREATE TABLE IF NOT EXISTS users (
+	id         TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL,
+	email_key  TEXT NOT NULL UNIQUE
+)`,
+	DialectPostgres: `
+CREATE TABLE IF NOT EXISTS users (
+	id         TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL,
+	email_key  TEXT NOT NULL UNIQUE
+)`,
+}
+
+// Migrate creates the users table if it does not already exist.
+func (s *SQLStore) Migrate(ctx context.Context) error {
+	schema, ok := sqlSchema[s.dialect]
+	if !ok {
+		return fmt.Errorf("user: unsupported SQL dialect %q", s.dialect)
+	}
+	_, err := s.db.ExecContext(ctx, schema)
+	return err
+}
+
+// placeholder returns the positional parameter marker for argument n
+// (1-indexed) in the store's dialect.
+func (s *SQLStore) placeholder(n int) string {
+	if s.dialect == DialectPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (s *SQLStore) Create(ctx context.Context, u *User) error {
+	q := fmt.Sprintf(
+		"INSERT INTO users (id, name, email, email_key) VALUES (%s, %s, %s, %s)",
+		s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4),
+	)
+	if _, err := s.db.ExecContext(ctx, q, u.ID, u.Name, u.Email, emailKey(u.Email)); err != nil {
+		if isUniqueViolation(err) {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+
+	created := *u
+	event.Emit(TopicUserCreated, &created)
+	return nil
+}
+
+func (s *SQLStore) Get(ctx context.Context, id string) (*User, error) {
+	q := fmt.Sprintf("SELECT id, name, email FROM users WHERE id = %s", s.placeholder(1))
+	return s.scanOne(ctx, q, id)
+}
+
+func (s *SQLStore) GetByEmail(ctx context.Context, email string) (*User, error) {
+	q := fmt.Sprintf("SELECT id, name, email FROM users WHERE email_key = %s", s.placeholder(1))
+	return s.scanOne(ctx, q, emailKey(email))
+}
+
+func (s *SQLStore) scanOne(ctx context.Context, query string, arg any) (*User, error) {
+	row := s.db.QueryRowContext(ctx, query, arg)
+	u := &User{}
+	if err := row.Scan(&u.ID, &u.Name, &u.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *SQLStore) Update(ctx context.Context, u *User) error {
+	old, err := s.Get(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+
+	q := fmt.Sprintf(
+		"UPDATE users SET name = %s, email = %s, email_key = %s WHERE id = %s",
+		s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4),
+	)
+	res, err := s.db.ExecContext(ctx, q, u.Name, u.Email, emailKey(u.Email), u.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+	if err := s.requireRowAffected(res); err != nil {
+		return err
+	}
+
+	updated := *u
+	emitUpdated(old, &updated)
+	return nil
+}
+
+func (s *SQLStore) Delete(ctx context.Context, id string) error {
+	q := fmt.Sprintf("DELETE FROM users WHERE id = %s", s.placeholder(1))
+	res, err := s.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireRowAffected(res); err != nil {
+		return err
+	}
+
+	event.Emit(TopicUserDeleted, &DeletedEvent{ID: id})
+	return nil
+}
+
+func (s *SQLStore) requireRowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLStore) List(ctx context.Context, filter ListFilter, opts ListOptions) ([]*User, string, error) {
+	offset, limit, err := resolveListOptions(opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	q := "SELECT id, name, email FROM users WHERE 1=1"
+	var args []any
+	// LOWER() on both sides makes the match case-insensitive regardless of
+	// dialect, since plain LIKE is case-sensitive on Postgres (unlike
+	// SQLite), and must still agree with MemoryStore/FileStore's
+	// containsFold.
+	if filter.NameContains != "" {
+		args = append(args, "%"+strings.ToLower(filter.NameContains)+"%")
+		q += fmt.Sprintf(" AND LOWER(name) LIKE %s", s.placeholder(len(args)))
+	}
+	if filter.EmailContains != "" {
+		args = append(args, "%"+strings.ToLower(filter.EmailContains)+"%")
+		q += fmt.Sprintf(" AND LOWER(email) LIKE %s", s.placeholder(len(args)))
+	}
+	q += " ORDER BY id"
+
+	// Fetch one extra row so we know whether a next page exists.
+	args = append(args, limit+1)
+	q += fmt.Sprintf(" LIMIT %s", s.placeholder(len(args)))
+	args = append(args, offset)
+	q += fmt.Sprintf(" OFFSET %s", s.placeholder(len(args)))
+
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var users []*User
+	for rows.Next() {
+		u := &User{}
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email); err != nil {
+			return nil, "", err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(users) > limit {
+		users = users[:limit]
+		next = encodePageToken(pageToken{Offset: offset + limit, Limit: limit})
+	}
+	return users, next, nil
+}
+
+// isUniqueViolation reports whether err looks like a unique-constraint
+// violation, without importing a specific driver's error type.
+func isUniqueViolation(err error) bool {
+	msg := err.Error()
+	return containsFold(msg, "unique") || containsFold(msg, "duplicate")
+}
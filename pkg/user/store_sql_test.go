@@ -0,0 +1,158 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
+)
+
+func newTestSQLStore(t *testing.T) *SQLStore {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store := NewSQLStore(db, DialectSQLite)
+	if err := store.Migrate(context.Background()); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	return store
+}
+
+func TestSQLStoreCreateGetUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLStore(t)
+
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	if err := store.Create(ctx, u); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := store.Create(ctx, u); err != ErrAlreadyExists {
+		t.Errorf("Create() duplicate = %v, want ErrAlreadyExists", err)
+	}
+
+	got, err := store.Get(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Email != u.Email {
+		t.Errorf("Get().Email = %s, want %s", got.Email, u.Email)
+	}
+
+	got, err = store.GetByEmail(ctx, "ADA@EXAMPLE.COM")
+	if err != nil {
+		t.Fatalf("GetByEmail() error = %v", err)
+	}
+	if got.ID != u.ID {
+		t.Errorf("GetByEmail().ID = %s, want %s", got.ID, u.ID)
+	}
+
+	u.Name = "Ada King"
+	if err := store.Update(ctx, u); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got, _ = store.Get(ctx, u.ID)
+	if got.Name != "Ada King" {
+		t.Errorf("Get().Name after update = %s, want Ada King", got.Name)
+	}
+
+	if err := store.Delete(ctx, u.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, u.ID); err != ErrNotFound {
+		t.Errorf("Get() after delete = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSQLStoreCreateDuplicateEmailDifferentCase(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLStore(t)
+
+	first := NewUser(identity.Person{Name: "Ada Lovelace", Email: "Alice@EXAMPLE.com"})
+	if err := store.Create(ctx, first); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	second := NewUser(identity.Person{Name: "Someone Else", Email: "alice@example.com"})
+	if err := store.Create(ctx, second); err != ErrAlreadyExists {
+		t.Errorf("Create() with case-variant duplicate email = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestSQLStoreList(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLStore(t)
+
+	for i := 0; i < 5; i++ {
+		u := NewUser(identity.Person{Name: "User", Email: sqlTestEmail(i)})
+		if err := store.Create(ctx, u); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	var all []*User
+	opts := ListOptions{Limit: 2}
+	for {
+		page, next, err := store.List(ctx, ListFilter{}, opts)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		all = append(all, page...)
+		if next == "" {
+			break
+		}
+		opts = ListOptions{PageToken: next}
+	}
+
+	if len(all) != 5 {
+		t.Fatalf("List() paged through %d users, want 5", len(all))
+	}
+}
+
+func TestSQLStoreListFilterIsCaseInsensitive(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLStore(t)
+
+	u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+	if err := store.Create(ctx, u); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	page, _, err := store.List(ctx, ListFilter{NameContains: "LOVELACE"}, ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page) != 1 || page[0].ID != u.ID {
+		t.Errorf("List(NameContains=LOVELACE) = %v, want [%s]", page, u.ID)
+	}
+
+	page, _, err = store.List(ctx, ListFilter{EmailContains: "ADA@EXAMPLE"}, ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page) != 1 || page[0].ID != u.ID {
+		t.Errorf("List(EmailContains=ADA@EXAMPLE) = %v, want [%s]", page, u.ID)
+	}
+}
+
+func TestSQLStoreListRejectsForgedNegativeOffsetToken(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLStore(t)
+
+	forged := encodePageToken(pageToken{Offset: -1, Limit: 10})
+	if _, _, err := store.List(ctx, ListFilter{}, ListOptions{PageToken: forged}); err == nil {
+		t.Error("List() with forged negative-offset token error = nil, want error")
+	}
+}
+
+func sqlTestEmail(i int) string {
+	return string(rune('a'+i)) + "@example.com"
+}
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
+)
+
+// storeFactories lets the shared test suite below run against every Store
+// implementation that doesn't require an external dependency (i.e. not
+// SQLStore, which needs a registered database/sql driver).
+func storeFactories(t *testing.T) map[string]Store {
+	return map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(t.TempDir(), "users.json")),
+	}
+}
+
+func TestStoreCreateGetUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range storeFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			u := NewUser(identity.Person{Name: "Ada Lovelace", Email: "ada@example.com"})
+
+			if err := store.Create(ctx, u); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if err := store.Create(ctx, u); err != ErrAlreadyExists {
+				t.Errorf("Create() duplicate = %v, want ErrAlreadyExists", err)
+			}
+
+			got, err := store.Get(ctx, u.ID)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got.Email != u.Email {
+				t.Errorf("Get().Email = %s, want %s", got.Email, u.Email)
+			}
+
+			got, err = store.GetByEmail(ctx, "ADA@EXAMPLE.COM")
+			if err != nil {
+				t.Fatalf("GetByEmail() error = %v", err)
+			}
+			if got.ID != u.ID {
+				t.Errorf("GetByEmail().ID = %s, want %s", got.ID, u.ID)
+			}
+
+			u.Name = "Ada King"
+			if err := store.Update(ctx, u); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			got, _ = store.Get(ctx, u.ID)
+			if got.Name != "Ada King" {
+				t.Errorf("Get().Name after update = %s, want Ada King", got.Name)
+			}
+
+			if err := store.Delete(ctx, u.ID); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if _, err := store.Get(ctx, u.ID); err != ErrNotFound {
+				t.Errorf("Get() after delete = %v, want ErrNotFound", err)
+			}
+			if err := store.Delete(ctx, u.ID); err != ErrNotFound {
+				t.Errorf("Delete() of missing user = %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestStoreListPagination(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range storeFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				u := NewUser(identity.Person{Name: "User", Email: fmt.Sprintf("user%d@example.com", i)})
+				if err := store.Create(ctx, u); err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+			}
+
+			var all []*User
+			opts := ListOptions{Limit: 2}
+			for {
+				page, next, err := store.List(ctx, ListFilter{}, opts)
+				if err != nil {
+					t.Fatalf("List() error = %v", err)
+				}
+				all = append(all, page...)
+				if next == "" {
+					break
+				}
+				opts = ListOptions{PageToken: next}
+			}
+
+			if len(all) != 5 {
+				t.Fatalf("List() paged through %d users, want 5", len(all))
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"malformed base64", "not-valid-base64!!"},
+		{"negative offset", encodePageToken(pageToken{Offset: -1, Limit: 10})},
+		{"negative limit", encodePageToken(pageToken{Offset: 0, Limit: -1})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePageToken(tt.in); err == nil {
+				t.Errorf("decodePageToken(%q) error = nil, want error", tt.in)
+			}
+		})
+	}
+}
+
+func TestStoreListRejectsForgedNegativeOffsetToken(t *testing.T) {
+	ctx := context.Background()
+	forged := encodePageToken(pageToken{Offset: -1, Limit: 10})
+
+	for name, store := range storeFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := store.List(ctx, ListFilter{}, ListOptions{PageToken: forged}); err == nil {
+				t.Error("List() with forged negative-offset token error = nil, want error")
+			}
+		})
+	}
+}
+
@@ -2,8 +2,10 @@ package user
 
 import (
 	"fmt"
-	"regexp"
+
 	"github.com/google/uuid"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
 )
 
 // User represents a user in the system
@@ -11,23 +13,30 @@ type User struct {
 	ID    string
 	Name  string
 	Email string
+
+	// Username is a short, unique handle distinct from Name, surfaced as
+	// the OIDC "preferred_username" claim.
+	Username string
+	// DisplayName, when set, is preferred over Name for the OIDC "name"
+	// claim.
+	DisplayName string
+	// EmailVerified indicates whether Email has been confirmed as
+	// reachable by the user.
+	EmailVerified bool
 }
 
-// NewUser creates a new user with a generated UUID
-func NewUser(name, email string) *User {
+// NewUser creates a new user with a generated UUID from a resolved identity.
+func NewUser(p identity.Person) *User {
 	return &User{
 		ID:    uuid.New().String(),
-		Name:  name,
-		Email: email,
+		Name:  p.Name,
+		Email: p.Email,
 	}
 }
 
-// IsValidEmail checks if the user's email is valid
+// IsValidEmail checks if the user's email is a valid, bare address.
 func (u *User) IsValidEmail() bool {
-	// Simple email validation pattern
-	pattern := `^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`
-	matched, _ := regexp.MatchString(pattern, u.Email)
-	return matched
+	return ValidateEmail(u.Email) == nil
 }
 
 // String returns a string representation of the user
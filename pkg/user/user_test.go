@@ -2,13 +2,15 @@ package user
 
 import (
 	"testing"
+
+	"github.com/davealexenglish/projectShell/pkg/identity"
 )
 
 func TestNewUser(t *testing.T) {
 	name := "John Doe"
 	email := "john@example.com"
 
-	u := NewUser(name, email)
+	u := NewUser(identity.Person{Name: name, Email: email})
 
 	if u.Name != name {
 		t.Errorf("Expected name %s, got %s", name, u.Name)
@@ -32,15 +34,18 @@ func TestIsValidEmail(t *testing.T) {
 		{"valid email", "test@example.com", true},
 		{"valid with subdomain", "test@mail.example.com", true},
 		{"valid with plus", "test+tag@example.com", true},
+		{"valid unicode local part", "tëst@example.com", true},
 		{"invalid no @", "testexample.com", false},
 		{"invalid no domain", "test@", false},
 		{"invalid no user", "@example.com", false},
 		{"invalid spaces", "test @example.com", false},
+		{"invalid display name", "Alice <alice@example.com>", false},
+		{"invalid trailing whitespace", "test@example.com ", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			u := NewUser("Test", tt.email)
+			u := NewUser(identity.Person{Name: "Test", Email: tt.email})
 			got := u.IsValidEmail()
 			if got != tt.want {
 				t.Errorf("IsValidEmail() = %v, want %v for email %s", got, tt.want, tt.email)